@@ -0,0 +1,211 @@
+/*
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1beta1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+const (
+	Group                = "external-secrets.io"
+	Version              = "v1beta1"
+	ExtSecretKind        = "ExternalSecret"
+	ClusterExtSecretKind = "ClusterExternalSecret"
+)
+
+// ExternalSecretMetadata defines metadata fields for the ExternalSecret generated by ClusterExternalSecret.
+type ExternalSecretMetadata struct {
+	Labels      map[string]string `json:"labels,omitempty"`
+	Annotations map[string]string `json:"annotations,omitempty"`
+}
+
+// ClusterExternalSecretSpec defines the desired state of ClusterExternalSecret.
+type ClusterExternalSecretSpec struct {
+	// The spec for the ExternalSecrets to be created
+	ExternalSecretSpec ExternalSecretSpec `json:"externalSecretSpec"`
+
+	// The name of the external secrets to be created defaults to the name of the ClusterExternalSecret
+	// +optional
+	ExternalSecretName string `json:"externalSecretName,omitempty"`
+
+	// The metadata of the external secrets to be created
+	// +optional
+	ExternalSecretMetadata ExternalSecretMetadata `json:"externalSecretMetadata,omitempty"`
+
+	// NamespaceSelector is a label selector used to select namespaces for the ClusterExternalSecret.
+	// +optional
+	NamespaceSelector metav1.LabelSelector `json:"namespaceSelector,omitempty"`
+
+	// NamespaceSelectors is a list of label selectors used to select namespaces for the
+	// ClusterExternalSecret. A namespace is targeted if it matches any of the selectors
+	// (logical OR), in addition to anything matched via NamespaceSelector or Namespaces.
+	// +optional
+	NamespaceSelectors []metav1.LabelSelector `json:"namespaceSelectors,omitempty"`
+
+	// Namespaces is an explicit allow-list of namespace names (supports glob patterns, e.g.
+	// "team-*") that the ClusterExternalSecret should target, in addition to anything matched
+	// by NamespaceSelector or NamespaceSelectors.
+	// +optional
+	Namespaces []string `json:"namespaces,omitempty"`
+
+	// ExcludeNamespaces is a deny-list of namespace names (supports glob patterns) that are
+	// removed from the set of namespaces otherwise matched by NamespaceSelector,
+	// NamespaceSelectors, or Namespaces.
+	// +optional
+	ExcludeNamespaces []string `json:"excludeNamespaces,omitempty"`
+
+	// NamespaceTemplate describes the metadata to apply to target namespaces named literally (not
+	// by a glob pattern) in Namespaces. When CreateIfMissing is set, the controller creates the namespace
+	// with this metadata before provisioning the ExternalSecret, or patches in any missing
+	// labels/annotations if the namespace already exists.
+	// +optional
+	NamespaceTemplate *NamespaceTemplate `json:"namespaceTemplate,omitempty"`
+
+	// The time in which the controller should wait before reconciling again
+	// +optional
+	RefreshInterval *metav1.Duration `json:"refreshTime,omitempty"`
+}
+
+// NamespaceTemplate defines the metadata that should be applied to auto-created namespaces.
+type NamespaceTemplate struct {
+	// +optional
+	Labels map[string]string `json:"labels,omitempty"`
+
+	// +optional
+	Annotations map[string]string `json:"annotations,omitempty"`
+
+	// CreateIfMissing controls whether the controller is allowed to create namespaces from
+	// Namespaces that do not exist yet.
+	// +optional
+	CreateIfMissing bool `json:"createIfMissing,omitempty"`
+}
+
+// ClusterExternalSecretNamespaceFailureReason is a machine-readable identifier for why a
+// namespace failed to reconcile, stable across releases so it is safe to build kubectl JSONPath
+// filters or alerting rules against.
+type ClusterExternalSecretNamespaceFailureReason string
+
+const (
+	// ReasonNamespaceTerminating means the namespace is being deleted and was skipped rather than
+	// failed.
+	ReasonNamespaceTerminating ClusterExternalSecretNamespaceFailureReason = "NamespaceTerminating"
+
+	// ReasonExternalSecretOrphaned means an ExternalSecret with the expected name already exists
+	// in the namespace but isn't owned by this ClusterExternalSecret.
+	ReasonExternalSecretOrphaned ClusterExternalSecretNamespaceFailureReason = "ExternalSecretOrphanedInNamespace"
+
+	// ReasonGetExternalSecretFailed means the controller could not look up the existing
+	// ExternalSecret for the namespace.
+	ReasonGetExternalSecretFailed ClusterExternalSecretNamespaceFailureReason = "GetExternalSecretFailed"
+
+	// ReasonCreateOrUpdateFailed means creating or updating the namespace's ExternalSecret failed.
+	ReasonCreateOrUpdateFailed ClusterExternalSecretNamespaceFailureReason = "CreateOrUpdateFailed"
+
+	// ReasonDeleteFailed means deleting the ExternalSecret from a namespace that no longer matches
+	// failed.
+	ReasonDeleteFailed ClusterExternalSecretNamespaceFailureReason = "DeleteFailed"
+
+	// ReasonNamespaceCreateFailed means auto-creating or patching a namespace from
+	// Spec.NamespaceTemplate failed.
+	ReasonNamespaceCreateFailed ClusterExternalSecretNamespaceFailureReason = "NamespaceCreateFailed"
+)
+
+// ClusterExternalSecretNamespaceFailure represents a failed namespace deployment and its reason.
+type ClusterExternalSecretNamespaceFailure struct {
+	// Namespace is the namespace that failed when trying to apply an ExternalSecret
+	Namespace string `json:"namespace"`
+
+	// Reason is a machine-readable identifier for why the namespace failed
+	// +optional
+	Reason ClusterExternalSecretNamespaceFailureReason `json:"reason,omitempty"`
+
+	// Message is a human-readable description of the failure
+	// +optional
+	Message string `json:"message,omitempty"`
+}
+
+// ClusterExternalSecretNamespaceSkip represents a namespace that was intentionally not reconciled
+// and the reason it was skipped.
+type ClusterExternalSecretNamespaceSkip struct {
+	// Namespace is the namespace that was skipped
+	Namespace string `json:"namespace"`
+
+	// Reason is a machine-readable identifier for why the namespace was skipped
+	// +optional
+	Reason ClusterExternalSecretNamespaceFailureReason `json:"reason,omitempty"`
+}
+
+// ClusterExternalSecretConditionType defines the condition type of a ClusterExternalSecret.
+type ClusterExternalSecretConditionType string
+
+const (
+	ClusterExternalSecretReady ClusterExternalSecretConditionType = "Ready"
+)
+
+// ClusterExternalSecretStatusCondition indicates the status of the ClusterExternalSecret.
+type ClusterExternalSecretStatusCondition struct {
+	Type   ClusterExternalSecretConditionType `json:"type"`
+	Status metav1.ConditionStatus             `json:"status"`
+
+	// +optional
+	Message string `json:"message,omitempty"`
+
+	// +optional
+	LastTransitionTime metav1.Time `json:"lastTransitionTime,omitempty"`
+}
+
+// ClusterExternalSecretStatus defines the observed state of ClusterExternalSecret.
+type ClusterExternalSecretStatus struct {
+	// +optional
+	Conditions []ClusterExternalSecretStatusCondition `json:"conditions,omitempty"`
+
+	// Failed namespaces are the namespaces that failed to apply an ExternalSecret
+	// +optional
+	FailedNamespaces []ClusterExternalSecretNamespaceFailure `json:"failedNamespaces,omitempty"`
+
+	// ProvisionedNamespaces are the namespaces where the ClusterExternalSecret has been applied
+	// +optional
+	ProvisionedNamespaces []string `json:"provisionedNamespaces,omitempty"`
+
+	// SkippedNamespaces are the namespaces that were intentionally not reconciled, e.g. because they
+	// are in the process of being terminated
+	// +optional
+	SkippedNamespaces []ClusterExternalSecretNamespaceSkip `json:"skippedNamespaces,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+// +kubebuilder:resource:scope=Cluster
+// +kubebuilder:subresource:status
+// +kubebuilder:printcolumn:name="Store",type=string,JSONPath=".spec.externalSecretSpec.secretStoreRef.name"
+// +kubebuilder:printcolumn:name="Refresh Interval",type=string,JSONPath=".spec.externalSecretSpec.refreshInterval"
+// +kubebuilder:printcolumn:name="Status",type=string,JSONPath=".status.conditions[?(@.type==\"Ready\")].reason"
+
+// ClusterExternalSecret is the Schema for the clusterexternalsecrets API.
+type ClusterExternalSecret struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   ClusterExternalSecretSpec   `json:"spec,omitempty"`
+	Status ClusterExternalSecretStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// ClusterExternalSecretList contains a list of ClusterExternalSecret resources.
+type ClusterExternalSecretList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []ClusterExternalSecret `json:"items"`
+}
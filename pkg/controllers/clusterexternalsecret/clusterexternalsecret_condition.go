@@ -0,0 +1,75 @@
+/*
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package clusterexternalsecret
+
+import (
+	"fmt"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	esv1beta1 "github.com/external-secrets/external-secrets/apis/externalsecrets/v1beta1"
+)
+
+const (
+	messageNoNamespacesMatched = "no namespaces matched the configured selectors/allow-list"
+	messageEmptySelector       = "no namespace selector, allow-list, or namespace selectors configured; targeting all namespaces"
+)
+
+// NewClusterExternalSecretCondition derives the Ready condition for a ClusterExternalSecret from the
+// outcome of the last reconcile. provisionedNamespaces is the set of namespaces actually reconciled,
+// which excludes anything moved to SkippedNamespaces (e.g. a matched namespace that is Terminating).
+// note carries extra context about how the target namespace set was resolved, e.g. distinguishing an
+// empty selector from a selector that simply matched nothing.
+func NewClusterExternalSecretCondition(failedNamespaces map[string]namespaceFailure, provisionedNamespaces []string, note string) *esv1beta1.ClusterExternalSecretStatusCondition {
+	if len(failedNamespaces) > 0 {
+		return &esv1beta1.ClusterExternalSecretStatusCondition{
+			Type:    esv1beta1.ClusterExternalSecretReady,
+			Status:  metav1.ConditionFalse,
+			Message: fmt.Sprintf("%s: %d", errNamespacesFailed, len(failedNamespaces)),
+		}
+	}
+
+	message := fmt.Sprintf("successfully reconciled %d namespaces", len(provisionedNamespaces))
+	if note != "" {
+		message = note
+	}
+
+	return &esv1beta1.ClusterExternalSecretStatusCondition{
+		Type:    esv1beta1.ClusterExternalSecretReady,
+		Status:  metav1.ConditionTrue,
+		Message: message,
+	}
+}
+
+// SetClusterExternalSecretCondition updates the condition on the ClusterExternalSecret status,
+// replacing an existing condition of the same type or appending a new one.
+func SetClusterExternalSecretCondition(ces *esv1beta1.ClusterExternalSecret, condition esv1beta1.ClusterExternalSecretStatusCondition) {
+	condition.LastTransitionTime = metav1.Now()
+
+	for i, existing := range ces.Status.Conditions {
+		if existing.Type != condition.Type {
+			continue
+		}
+
+		if existing.Status == condition.Status {
+			condition.LastTransitionTime = existing.LastTransitionTime
+		}
+
+		ces.Status.Conditions[i] = condition
+		return
+	}
+
+	ces.Status.Conditions = append(ces.Status.Conditions, condition)
+}
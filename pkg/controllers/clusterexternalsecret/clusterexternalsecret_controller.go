@@ -16,11 +16,14 @@ package clusterexternalsecret
 
 import (
 	"context"
+	"path"
 	"reflect"
 	"sort"
+	"sync"
 	"time"
 
 	"github.com/go-logr/logr"
+	"golang.org/x/sync/errgroup"
 	v1 "k8s.io/api/core/v1"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -28,6 +31,7 @@ import (
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/runtime/schema"
 	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/record"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/builder"
 	"sigs.k8s.io/controller-runtime/pkg/client"
@@ -49,8 +53,25 @@ type Reconciler struct {
 	Log             logr.Logger
 	Scheme          *runtime.Scheme
 	RequeueInterval time.Duration
+	Recorder        record.EventRecorder
+
+	// MaxConcurrentNamespaces bounds how many namespaces are resolved in parallel for a single
+	// ClusterExternalSecret. Defaults to defaultMaxConcurrentNamespaces when unset.
+	MaxConcurrentNamespaces int
+}
+
+// namespaceFailure pairs a machine-readable Reason with a human-readable Message for a single
+// namespace so both can be threaded through to the status condition and to the Warning Event
+// emitted on the ClusterExternalSecret.
+type namespaceFailure struct {
+	Reason  esv1beta1.ClusterExternalSecretNamespaceFailureReason
+	Message string
 }
 
+// defaultMaxConcurrentNamespaces is used when Reconciler.MaxConcurrentNamespaces is unset, so a CES
+// matching hundreds of namespaces doesn't serialize its work onto a single controller worker.
+const defaultMaxConcurrentNamespaces = 10
+
 const (
 	errGetCES               = "could not get ClusterExternalSecret"
 	errPatchStatus          = "unable to patch status"
@@ -62,8 +83,34 @@ const (
 	errSecretAlreadyExists  = "external secret already exists in namespace"
 	errNamespacesFailed     = "one or more namespaces failed"
 	errFailedToDelete       = "external secret in non matching namespace could not be deleted"
+	errApplyNamespace       = "could not create or patch namespace from namespaceTemplate"
+	errAddFinalizer         = "unable to add finalizer"
+	errRemoveFinalizer      = "unable to remove finalizer"
+
+	messageNamespaceTerminating = "namespace is in Terminating phase, skipping"
+
+	// CESFinalizer ensures that ExternalSecrets provisioned by a ClusterExternalSecret, including
+	// ones that have drifted out of our ownership, are cleaned up before the CES itself is removed.
+	CESFinalizer = "clusterexternalsecret.external-secrets.io/finalizer"
+
+	// CESNameLabelKey is stamped onto every ExternalSecret a ClusterExternalSecret creates, in
+	// addition to whatever the user configured in Spec.ExternalSecretMetadata.Labels. It lets
+	// listOrphanedExternalSecretNamespaces find copies that drifted out of controller ownership
+	// (e.g. a manual edit removed the owner reference) regardless of whether the CES configures any
+	// labels of its own.
+	CESNameLabelKey = "clusterexternalsecret.external-secrets.io/name"
 )
 
+// recordWarning emits a Warning Event on clusterExternalSecret carrying reason as its typed Event
+// reason and message as its note. It does not log; callers log the failure themselves.
+// It is a no-op if no Recorder was wired up, e.g. in unit tests that construct a Reconciler by hand.
+func (r *Reconciler) recordWarning(clusterExternalSecret *esv1beta1.ClusterExternalSecret, reason esv1beta1.ClusterExternalSecretNamespaceFailureReason, message string) {
+	if r.Recorder == nil {
+		return
+	}
+	r.Recorder.Event(clusterExternalSecret, v1.EventTypeWarning, string(reason), message)
+}
+
 func (r *Reconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
 	log := r.Log.WithValues("ClusterExternalSecret", req.NamespacedName)
 
@@ -83,6 +130,18 @@ func (r *Reconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Resu
 		return ctrl.Result{}, nil
 	}
 
+	if !clusterExternalSecret.ObjectMeta.DeletionTimestamp.IsZero() {
+		return r.reconcileDeletion(ctx, log, &clusterExternalSecret)
+	}
+
+	if !controllerutil.ContainsFinalizer(&clusterExternalSecret, CESFinalizer) {
+		controllerutil.AddFinalizer(&clusterExternalSecret, CESFinalizer)
+		if err := r.Update(ctx, &clusterExternalSecret); err != nil {
+			log.Error(err, errAddFinalizer)
+			return ctrl.Result{}, err
+		}
+	}
+
 	p := client.MergeFrom(clusterExternalSecret.DeepCopy())
 	defer r.deferPatch(ctx, log, &clusterExternalSecret, p)
 
@@ -91,14 +150,7 @@ func (r *Reconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Resu
 		refreshInt = clusterExternalSecret.Spec.RefreshInterval.Duration
 	}
 
-	labelSelector, err := metav1.LabelSelectorAsSelector(&clusterExternalSecret.Spec.NamespaceSelector)
-	if err != nil {
-		log.Error(err, errConvertLabelSelector)
-		return ctrl.Result{RequeueAfter: refreshInt}, err
-	}
-
-	namespaceList := v1.NamespaceList{}
-	err = r.List(ctx, &namespaceList, &client.ListOptions{LabelSelector: labelSelector})
+	namespaceList, selectorNote, namespaceCreateFailures, err := r.getMatchingNamespaces(ctx, clusterExternalSecret.Spec)
 	if err != nil {
 		log.Error(err, errNamespaces)
 		return ctrl.Result{RequeueAfter: refreshInt}, err
@@ -109,88 +161,431 @@ func (r *Reconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Resu
 		esName = clusterExternalSecret.ObjectMeta.Name
 	}
 
-	failedNamespaces := r.removeOldNamespaces(ctx, namespaceList, esName, clusterExternalSecret.Status.ProvisionedNamespaces)
+	failedNamespaces := r.removeOldNamespaces(ctx, &clusterExternalSecret, namespaceList, esName, clusterExternalSecret.Status.ProvisionedNamespaces)
+	for namespace, failure := range namespaceCreateFailures {
+		failedNamespaces[namespace] = failure
+		r.recordWarning(&clusterExternalSecret, failure.Reason, failure.Message)
+	}
+
+	provisionedNamespaces, skippedNamespaces := r.resolveNamespacesConcurrently(ctx, log, &clusterExternalSecret, namespaceList, esName, failedNamespaces)
+
+	condition := NewClusterExternalSecretCondition(failedNamespaces, provisionedNamespaces, selectorNote)
+	SetClusterExternalSecretCondition(&clusterExternalSecret, *condition)
+
+	clusterExternalSecret.Status.FailedNamespaces = toNamespaceFailures(failedNamespaces)
+	clusterExternalSecret.Status.ProvisionedNamespaces = provisionedNamespaces
+	clusterExternalSecret.Status.SkippedNamespaces = skippedNamespaces
+
+	return ctrl.Result{RequeueAfter: refreshInt}, nil
+}
+
+// resolveNamespacesConcurrently resolves (creates/updates) the ExternalSecret for every namespace
+// in namespaceList, bounded by Reconciler.MaxConcurrentNamespaces concurrent goroutines so that a
+// ClusterExternalSecret matching hundreds of namespaces doesn't block the controller worker for the
+// whole reconcile. failedNamespaces is shared with the caller and is safe to keep mutating after
+// this returns, since the goroutines in the errgroup have all completed by then.
+func (r *Reconciler) resolveNamespacesConcurrently(ctx context.Context, log logr.Logger, clusterExternalSecret *esv1beta1.ClusterExternalSecret, namespaceList v1.NamespaceList, esName string, failedNamespaces map[string]namespaceFailure) ([]string, []esv1beta1.ClusterExternalSecretNamespaceSkip) {
+	maxConcurrent := r.MaxConcurrentNamespaces
+	if maxConcurrent <= 0 {
+		maxConcurrent = defaultMaxConcurrentNamespaces
+	}
+
+	var mu sync.Mutex
 	provisionedNamespaces := []string{}
+	skippedNamespaces := []esv1beta1.ClusterExternalSecretNamespaceSkip{}
 
-	for _, namespace := range namespaceList.Items {
-		existingES, err := r.getExternalSecret(ctx, namespace.Name, esName)
+	g, _ := errgroup.WithContext(ctx)
+	g.SetLimit(maxConcurrent)
 
-		if result := checkForError(err, existingES); result != "" {
-			log.Error(err, result)
-			failedNamespaces[namespace.Name] = result
-			continue
+	for i := range namespaceList.Items {
+		namespace := namespaceList.Items[i]
+
+		g.Go(func() error {
+			nsLog := log.WithValues("namespace", namespace.Name)
+
+			if isNamespaceTerminating(&namespace) {
+				nsLog.V(1).Info(messageNamespaceTerminating)
+				r.recordWarning(clusterExternalSecret, esv1beta1.ReasonNamespaceTerminating, messageNamespaceTerminating)
+				mu.Lock()
+				skippedNamespaces = append(skippedNamespaces, esv1beta1.ClusterExternalSecretNamespaceSkip{
+					Namespace: namespace.ObjectMeta.Name,
+					Reason:    esv1beta1.ReasonNamespaceTerminating,
+				})
+				mu.Unlock()
+				return nil
+			}
+
+			resolveStart := time.Now()
+			defer func() {
+				cesmetrics.GetHistogramVec(cesmetrics.ClusterExternalSecretNamespaceResolveDurationKey).
+					WithLabelValues(clusterExternalSecret.Name).
+					Observe(time.Since(resolveStart).Seconds())
+			}()
+
+			existingES, err := r.getExternalSecret(ctx, namespace.Name, esName)
+			if reason, message := checkForError(err, existingES); reason != "" {
+				nsLog.Error(err, message)
+				r.recordWarning(clusterExternalSecret, reason, message)
+				mu.Lock()
+				failedNamespaces[namespace.Name] = namespaceFailure{Reason: reason, Message: message}
+				mu.Unlock()
+				return nil
+			}
+
+			if reason, message, err := r.resolveExternalSecret(ctx, clusterExternalSecret, existingES, namespace, esName, clusterExternalSecret.Spec.ExternalSecretMetadata); err != nil {
+				nsLog.Error(err, message)
+				r.recordWarning(clusterExternalSecret, reason, message)
+				mu.Lock()
+				failedNamespaces[namespace.Name] = namespaceFailure{Reason: reason, Message: message}
+				mu.Unlock()
+				return nil
+			}
+
+			mu.Lock()
+			provisionedNamespaces = append(provisionedNamespaces, namespace.ObjectMeta.Name)
+			mu.Unlock()
+			return nil
+		})
+	}
+
+	// Every goroutine above always returns nil: per-namespace failures are recorded in
+	// failedNamespaces rather than propagated, so one namespace's error doesn't cancel the rest.
+	_ = g.Wait()
+
+	sort.Strings(provisionedNamespaces)
+	sort.Slice(skippedNamespaces, func(i, j int) bool { return skippedNamespaces[i].Namespace < skippedNamespaces[j].Namespace })
+
+	return provisionedNamespaces, skippedNamespaces
+}
+
+// reconcileDeletion cleans up every ExternalSecret a ClusterExternalSecret is responsible for
+// before letting the delete through, covering both namespaces we know we provisioned
+// (Status.ProvisionedNamespaces) and any ExternalSecret elsewhere in the cluster carrying our
+// labels that drifted out of our ownership (e.g. a manual edit removed the owner reference). The
+// finalizer is only removed once every cleanup attempt has succeeded; otherwise we requeue.
+func (r *Reconciler) reconcileDeletion(ctx context.Context, log logr.Logger, clusterExternalSecret *esv1beta1.ClusterExternalSecret) (ctrl.Result, error) {
+	if !controllerutil.ContainsFinalizer(clusterExternalSecret, CESFinalizer) {
+		return ctrl.Result{}, nil
+	}
+
+	esName := clusterExternalSecret.Spec.ExternalSecretName
+	if esName == "" {
+		esName = clusterExternalSecret.ObjectMeta.Name
+	}
+
+	namespaces := map[string]struct{}{}
+	for _, namespace := range clusterExternalSecret.Status.ProvisionedNamespaces {
+		namespaces[namespace] = struct{}{}
+	}
+
+	orphaned, err := r.listOrphanedExternalSecretNamespaces(ctx, clusterExternalSecret, esName)
+	if err != nil {
+		log.Error(err, "unable to list external secrets for cleanup")
+		return ctrl.Result{RequeueAfter: r.RequeueInterval}, nil
+	}
+	for _, namespace := range orphaned {
+		namespaces[namespace] = struct{}{}
+	}
+
+	var cleanupFailed bool
+	for namespace := range namespaces {
+		if reason, message, err := r.removeExternalSecretForDeletion(ctx, esName, namespace); reason != "" {
+			log.Error(err, message, "namespace", namespace)
+			r.recordWarning(clusterExternalSecret, reason, message)
+			cleanupFailed = true
 		}
+	}
 
-		if result, err := r.resolveExternalSecret(ctx, &clusterExternalSecret, existingES, namespace, esName, clusterExternalSecret.Spec.ExternalSecretMetadata); err != nil {
-			log.Error(err, result)
-			failedNamespaces[namespace.Name] = result
-			continue
+	if cleanupFailed {
+		return ctrl.Result{RequeueAfter: r.RequeueInterval}, nil
+	}
+
+	controllerutil.RemoveFinalizer(clusterExternalSecret, CESFinalizer)
+	if err := r.Update(ctx, clusterExternalSecret); err != nil {
+		log.Error(err, errRemoveFinalizer)
+		return ctrl.Result{}, err
+	}
+
+	return ctrl.Result{}, nil
+}
+
+// listOrphanedExternalSecretNamespaces finds ExternalSecrets anywhere in the cluster that carry
+// CESNameLabelKey for this ClusterExternalSecret, so that copies which drifted out of our
+// ownership (e.g. an owner reference removed by hand) are still cleaned up on deletion. This label
+// is stamped unconditionally by resolveExternalSecret, independent of any user-configured
+// Spec.ExternalSecretMetadata.Labels, so the sweep isn't a no-op for CES objects that don't set any.
+func (r *Reconciler) listOrphanedExternalSecretNamespaces(ctx context.Context, clusterExternalSecret *esv1beta1.ClusterExternalSecret, esName string) ([]string, error) {
+	var externalSecrets esv1beta1.ExternalSecretList
+	if err := r.List(ctx, &externalSecrets, client.MatchingLabels{CESNameLabelKey: clusterExternalSecret.Name}); err != nil {
+		return nil, err
+	}
+
+	namespaces := make([]string, 0, len(externalSecrets.Items))
+	for _, externalSecret := range externalSecrets.Items {
+		if externalSecret.Name == esName {
+			namespaces = append(namespaces, externalSecret.Namespace)
 		}
+	}
+
+	return namespaces, nil
+}
+
+// isNamespaceTerminating returns true if the namespace is being deleted, either because it has
+// moved into the Terminating phase or because a deletion has already been requested. The API
+// server rejects creates against such namespaces, so callers should treat them as skipped rather
+// than failed.
+func isNamespaceTerminating(namespace *v1.Namespace) bool {
+	return namespace.Status.Phase == v1.NamespaceTerminating || !namespace.ObjectMeta.DeletionTimestamp.IsZero()
+}
 
-		provisionedNamespaces = append(provisionedNamespaces, namespace.ObjectMeta.Name)
+// getMatchingNamespaces resolves the set of namespaces a ClusterExternalSecret targets by
+// composing Spec.NamespaceSelector, Spec.NamespaceSelectors, and Spec.Namespaces (logical OR),
+// then removing anything matched by Spec.ExcludeNamespaces. The returned note distinguishes an
+// unconfigured selector (which historically targets every namespace) from selectors that were
+// configured but matched nothing, so callers can surface that difference in the status condition.
+func (r *Reconciler) getMatchingNamespaces(ctx context.Context, spec esv1beta1.ClusterExternalSecretSpec) (v1.NamespaceList, string, map[string]namespaceFailure, error) {
+	selectorConfigured := hasNamespaceSelection(spec)
+	failedNamespaces := map[string]namespaceFailure{}
+	matched := map[string]v1.Namespace{}
+
+	if !selectorConfigured {
+		namespaceList := v1.NamespaceList{}
+		if err := r.List(ctx, &namespaceList); err != nil {
+			return v1.NamespaceList{}, "", nil, err
+		}
+		for _, namespace := range namespaceList.Items {
+			matched[namespace.Name] = namespace
+		}
 	}
 
-	condition := NewClusterExternalSecretCondition(failedNamespaces, &namespaceList)
-	SetClusterExternalSecretCondition(&clusterExternalSecret, *condition)
+	if !reflect.DeepEqual(spec.NamespaceSelector, metav1.LabelSelector{}) {
+		if err := r.listNamespacesBySelector(ctx, spec.NamespaceSelector, matched); err != nil {
+			return v1.NamespaceList{}, "", nil, err
+		}
+	}
 
-	clusterExternalSecret.Status.FailedNamespaces = toNamespaceFailures(failedNamespaces)
-	sort.Strings(provisionedNamespaces)
-	clusterExternalSecret.Status.ProvisionedNamespaces = provisionedNamespaces
+	for _, selector := range spec.NamespaceSelectors {
+		if err := r.listNamespacesBySelector(ctx, selector, matched); err != nil {
+			return v1.NamespaceList{}, "", nil, err
+		}
+	}
 
-	return ctrl.Result{RequeueAfter: refreshInt}, nil
+	if len(spec.Namespaces) > 0 {
+		allNamespaces := v1.NamespaceList{}
+		if err := r.List(ctx, &allNamespaces); err != nil {
+			return v1.NamespaceList{}, "", nil, err
+		}
+		for _, namespace := range allNamespaces.Items {
+			if namespaceNameMatches(namespace.Name, spec.Namespaces) {
+				matched[namespace.Name] = namespace
+			}
+		}
+
+		// ApplyNamespace is itself idempotent: it creates the namespace if missing, or patches in
+		// any labels/annotations from the template that an existing namespace doesn't already have.
+		// So it's called for every named (non-glob) entry, not just ones absent from allNamespaces.
+		if spec.NamespaceTemplate != nil && spec.NamespaceTemplate.CreateIfMissing {
+			for _, name := range spec.Namespaces {
+				if isGlobPattern(name) {
+					continue
+				}
+
+				namespace, err := r.ApplyNamespace(ctx, name, *spec.NamespaceTemplate)
+				if err != nil {
+					r.Log.Error(err, errApplyNamespace, "namespace", name)
+					failedNamespaces[name] = namespaceFailure{Reason: esv1beta1.ReasonNamespaceCreateFailed, Message: errApplyNamespace}
+					continue
+				}
+				matched[name] = *namespace
+			}
+		}
+	}
+
+	for name := range matched {
+		if namespaceNameMatches(name, spec.ExcludeNamespaces) {
+			delete(matched, name)
+		}
+	}
+
+	namespaceList := v1.NamespaceList{Items: make([]v1.Namespace, 0, len(matched))}
+	for _, namespace := range matched {
+		namespaceList.Items = append(namespaceList.Items, namespace)
+	}
+	sort.Slice(namespaceList.Items, func(i, j int) bool { return namespaceList.Items[i].Name < namespaceList.Items[j].Name })
+
+	if !selectorConfigured {
+		return namespaceList, messageEmptySelector, failedNamespaces, nil
+	}
+
+	if len(namespaceList.Items) == 0 {
+		return namespaceList, messageNoNamespacesMatched, failedNamespaces, nil
+	}
+
+	return namespaceList, "", failedNamespaces, nil
+}
+
+// hasNamespaceSelection reports whether the ClusterExternalSecret configured any namespace
+// targeting at all. If not, the historical behaviour of targeting every namespace applies.
+func hasNamespaceSelection(spec esv1beta1.ClusterExternalSecretSpec) bool {
+	return !reflect.DeepEqual(spec.NamespaceSelector, metav1.LabelSelector{}) ||
+		len(spec.NamespaceSelectors) > 0 ||
+		len(spec.Namespaces) > 0
+}
+
+// specTargetsNamespace reports whether a single namespace (identified by name and labels) would be
+// targeted by spec, mirroring the composition rules applied by getMatchingNamespaces. It is used to
+// decide whether a namespace create/label event should requeue a given ClusterExternalSecret.
+func (r *Reconciler) specTargetsNamespace(spec esv1beta1.ClusterExternalSecretSpec, name string, namespaceLabels labels.Set) (bool, error) {
+	if namespaceNameMatches(name, spec.ExcludeNamespaces) {
+		return false, nil
+	}
+
+	if !hasNamespaceSelection(spec) {
+		return true, nil
+	}
+
+	if namespaceNameMatches(name, spec.Namespaces) {
+		return true, nil
+	}
+
+	if !reflect.DeepEqual(spec.NamespaceSelector, metav1.LabelSelector{}) {
+		selector, err := metav1.LabelSelectorAsSelector(&spec.NamespaceSelector)
+		if err != nil {
+			return false, err
+		}
+		if selector.Matches(namespaceLabels) {
+			return true, nil
+		}
+	}
+
+	for _, labelSelector := range spec.NamespaceSelectors {
+		selector, err := metav1.LabelSelectorAsSelector(&labelSelector)
+		if err != nil {
+			return false, err
+		}
+		if selector.Matches(namespaceLabels) {
+			return true, nil
+		}
+	}
+
+	return false, nil
 }
 
-func (r *Reconciler) resolveExternalSecret(ctx context.Context, clusterExternalSecret *esv1beta1.ClusterExternalSecret, existingES *metav1.PartialObjectMetadata, namespace v1.Namespace, esName string, esMetadata esv1beta1.ExternalSecretMetadata) (string, error) {
+func (r *Reconciler) listNamespacesBySelector(ctx context.Context, selector metav1.LabelSelector, matched map[string]v1.Namespace) error {
+	labelSelector, err := metav1.LabelSelectorAsSelector(&selector)
+	if err != nil {
+		return err
+	}
+
+	namespaceList := v1.NamespaceList{}
+	if err := r.List(ctx, &namespaceList, &client.ListOptions{LabelSelector: labelSelector}); err != nil {
+		return err
+	}
+
+	for _, namespace := range namespaceList.Items {
+		matched[namespace.Name] = namespace
+	}
+
+	return nil
+}
+
+// namespaceNameMatches reports whether name is present in patterns, either as an exact match or a
+// shell glob (e.g. "team-*").
+func namespaceNameMatches(name string, patterns []string) bool {
+	for _, pattern := range patterns {
+		if pattern == name {
+			return true
+		}
+		if ok, err := path.Match(pattern, name); err == nil && ok {
+			return true
+		}
+	}
+	return false
+}
+
+func (r *Reconciler) resolveExternalSecret(ctx context.Context, clusterExternalSecret *esv1beta1.ClusterExternalSecret, existingES *metav1.PartialObjectMetadata, namespace v1.Namespace, esName string, esMetadata esv1beta1.ExternalSecretMetadata) (esv1beta1.ClusterExternalSecretNamespaceFailureReason, string, error) {
 	// this means the existing ES does not belong to us
 	if err := controllerutil.SetControllerReference(clusterExternalSecret, existingES, r.Scheme); err != nil {
-		return errSetCtrlReference, err
+		return esv1beta1.ReasonCreateOrUpdateFailed, errSetCtrlReference, err
 	}
 
+	labels := map[string]string{}
+	for k, v := range esMetadata.Labels {
+		labels[k] = v
+	}
+	labels[CESNameLabelKey] = clusterExternalSecret.Name
+
 	externalSecret := esv1beta1.ExternalSecret{
 		ObjectMeta: metav1.ObjectMeta{
 			Name:        esName,
 			Namespace:   namespace.Name,
-			Labels:      esMetadata.Labels,
+			Labels:      labels,
 			Annotations: esMetadata.Annotations,
 		},
 		Spec: clusterExternalSecret.Spec.ExternalSecretSpec,
 	}
 
 	if err := controllerutil.SetControllerReference(clusterExternalSecret, &externalSecret, r.Scheme); err != nil {
-		return errSetCtrlReference, err
+		return esv1beta1.ReasonCreateOrUpdateFailed, errSetCtrlReference, err
 	}
 
 	mutateFunc := func() error {
 		externalSecret.Spec = clusterExternalSecret.Spec.ExternalSecretSpec
+		if externalSecret.ObjectMeta.Labels == nil {
+			externalSecret.ObjectMeta.Labels = map[string]string{}
+		}
+		externalSecret.ObjectMeta.Labels[CESNameLabelKey] = clusterExternalSecret.Name
 		return nil
 	}
 
 	// An empty mutate func as nothing needs to happen currently
 	if _, err := ctrl.CreateOrUpdate(ctx, r.Client, &externalSecret, mutateFunc); err != nil {
-		return errCreatingOrUpdating, err
+		return esv1beta1.ReasonCreateOrUpdateFailed, errCreatingOrUpdating, err
 	}
 
-	return "", nil
+	return "", "", nil
 }
 
-func (r *Reconciler) removeExternalSecret(ctx context.Context, esName, namespace string) (string, error) {
+func (r *Reconciler) removeExternalSecret(ctx context.Context, esName, namespace string) (esv1beta1.ClusterExternalSecretNamespaceFailureReason, string, error) {
 	existingES, err := r.getExternalSecret(ctx, namespace, esName)
 	// If we can't find it then just leave
 	if err != nil && apierrors.IsNotFound(err) {
-		return "", nil
+		return "", "", nil
 	}
 
-	if result := checkForError(err, existingES); result != "" {
-		return result, err
+	if reason, message := checkForError(err, existingES); reason != "" {
+		return reason, message, err
 	}
 
 	err = r.Delete(ctx, existingES, &client.DeleteOptions{})
 
 	if err != nil {
-		return errFailedToDelete, err
+		return esv1beta1.ReasonDeleteFailed, errFailedToDelete, err
+	}
+
+	return "", "", nil
+}
+
+// removeExternalSecretForDeletion deletes the namespace's ExternalSecret unconditionally, without
+// checkForError's ownership guard. That guard exists to stop a normal reconcile from touching an
+// ExternalSecret it doesn't own; during CES deletion the opposite is required, since an orphaned
+// ExternalSecret (owner reference removed by hand) is exactly the case the cleanup sweep in
+// reconcileDeletion is meant to catch, and it must still be deleted rather than reported as a
+// permanent failure.
+func (r *Reconciler) removeExternalSecretForDeletion(ctx context.Context, esName, namespace string) (esv1beta1.ClusterExternalSecretNamespaceFailureReason, string, error) {
+	existingES, err := r.getExternalSecret(ctx, namespace, esName)
+	if err != nil {
+		if apierrors.IsNotFound(err) {
+			return "", "", nil
+		}
+		return esv1beta1.ReasonGetExternalSecretFailed, errGetExistingES, err
+	}
+
+	if err := r.Delete(ctx, existingES, &client.DeleteOptions{}); err != nil {
+		return esv1beta1.ReasonDeleteFailed, errFailedToDelete, err
 	}
 
-	return "", nil
+	return "", "", nil
 }
 
 func (r *Reconciler) deferPatch(ctx context.Context, log logr.Logger, clusterExternalSecret *esv1beta1.ClusterExternalSecret, p client.Patch) {
@@ -199,22 +594,43 @@ func (r *Reconciler) deferPatch(ctx context.Context, log logr.Logger, clusterExt
 	}
 }
 
-func (r *Reconciler) removeOldNamespaces(ctx context.Context, namespaceList v1.NamespaceList, esName string, provisionedNamespaces []string) map[string]string {
-	failedNamespaces := map[string]string{}
+func (r *Reconciler) removeOldNamespaces(ctx context.Context, clusterExternalSecret *esv1beta1.ClusterExternalSecret, namespaceList v1.NamespaceList, esName string, provisionedNamespaces []string) map[string]namespaceFailure {
+	failedNamespaces := map[string]namespaceFailure{}
 	// Loop through existing namespaces first to make sure they still have our labels
 	for _, namespace := range getRemovedNamespaces(namespaceList, provisionedNamespaces) {
-		result, err := r.removeExternalSecret(ctx, esName, namespace)
+		// The namespace is already being torn down, so the ExternalSecret in it either no
+		// longer exists or is about to be garbage collected along with the namespace. Deleting
+		// it ourselves would just race the namespace controller and flip the condition to
+		// failing for no reason.
+		if r.isRemovedNamespaceTerminating(ctx, namespace) {
+			continue
+		}
+
+		reason, message, err := r.removeExternalSecret(ctx, esName, namespace)
 		if err != nil {
 			r.Log.Error(err, "unable to delete external-secret")
 		}
-		if result != "" {
-			failedNamespaces[namespace] = result
+		if reason != "" {
+			r.recordWarning(clusterExternalSecret, reason, message)
+			failedNamespaces[namespace] = namespaceFailure{Reason: reason, Message: message}
 		}
 	}
 
 	return failedNamespaces
 }
 
+// isRemovedNamespaceTerminating reports whether a namespace that is no longer targeted by the
+// ClusterExternalSecret is itself being deleted. Missing namespaces are treated the same as
+// terminating ones, since there is nothing left to clean up.
+func (r *Reconciler) isRemovedNamespaceTerminating(ctx context.Context, name string) bool {
+	var namespace v1.Namespace
+	if err := r.Get(ctx, types.NamespacedName{Name: name}, &namespace); err != nil {
+		return apierrors.IsNotFound(err)
+	}
+
+	return isNamespaceTerminating(&namespace)
+}
+
 func (r *Reconciler) getExternalSecret(ctx context.Context, namespace, name string) (*metav1.PartialObjectMetadata, error) {
 	// Should not use esv1beta1.ExternalSecret since we specify builder.OnlyMetadata and cache only metadata
 	metadata := metav1.PartialObjectMetadata{}
@@ -227,17 +643,17 @@ func (r *Reconciler) getExternalSecret(ctx context.Context, namespace, name stri
 	return &metadata, err
 }
 
-func checkForError(getError error, existingES *metav1.PartialObjectMetadata) string {
+func checkForError(getError error, existingES *metav1.PartialObjectMetadata) (esv1beta1.ClusterExternalSecretNamespaceFailureReason, string) {
 	if getError != nil && !apierrors.IsNotFound(getError) {
-		return errGetExistingES
+		return esv1beta1.ReasonGetExternalSecretFailed, errGetExistingES
 	}
 
 	// No one owns this resource so error out
 	if !apierrors.IsNotFound(getError) && len(existingES.ObjectMeta.OwnerReferences) == 0 {
-		return errSecretAlreadyExists
+		return esv1beta1.ReasonExternalSecretOrphaned, errSecretAlreadyExists
 	}
 
-	return ""
+	return "", ""
 }
 
 func getRemovedNamespaces(nsList v1.NamespaceList, provisionedNs []string) []string {
@@ -257,14 +673,15 @@ func getRemovedNamespaces(nsList v1.NamespaceList, provisionedNs []string) []str
 	return removedNamespaces
 }
 
-func toNamespaceFailures(failedNamespaces map[string]string) []esv1beta1.ClusterExternalSecretNamespaceFailure {
+func toNamespaceFailures(failedNamespaces map[string]namespaceFailure) []esv1beta1.ClusterExternalSecretNamespaceFailure {
 	namespaceFailures := make([]esv1beta1.ClusterExternalSecretNamespaceFailure, len(failedNamespaces))
 
 	i := 0
-	for namespace, message := range failedNamespaces {
+	for namespace, failure := range failedNamespaces {
 		namespaceFailures[i] = esv1beta1.ClusterExternalSecretNamespaceFailure{
 			Namespace: namespace,
-			Reason:    message,
+			Reason:    failure.Reason,
+			Message:   failure.Message,
 		}
 		i++
 	}
@@ -274,6 +691,10 @@ func toNamespaceFailures(failedNamespaces map[string]string) []esv1beta1.Cluster
 
 // SetupWithManager sets up the controller with the Manager.
 func (r *Reconciler) SetupWithManager(mgr ctrl.Manager, opts controller.Options) error {
+	if r.Recorder == nil {
+		r.Recorder = mgr.GetEventRecorderFor("clusterexternalsecret-controller")
+	}
+
 	return ctrl.NewControllerManagedBy(mgr).
 		WithOptions(opts).
 		For(&esv1beta1.ClusterExternalSecret{}).
@@ -303,13 +724,13 @@ func (r *Reconciler) findObjectsForNamespace(ctx context.Context, namespace clie
 
 		for i := range clusterExternalSecrets.Items {
 			clusterExternalSecret := &clusterExternalSecrets.Items[i]
-			labelSelector, err := metav1.LabelSelectorAsSelector(&clusterExternalSecret.Spec.NamespaceSelector)
+			matches, err := r.specTargetsNamespace(clusterExternalSecret.Spec, namespace.GetName(), namespaceLabels)
 			if err != nil {
 				r.Log.Error(err, errConvertLabelSelector)
 				return []reconcile.Request{}
 			}
 
-			if labelSelector.Matches(namespaceLabels) {
+			if matches {
 				requests = append(requests, reconcile.Request{
 					NamespacedName: types.NamespacedName{
 						Name:      clusterExternalSecret.GetName(),
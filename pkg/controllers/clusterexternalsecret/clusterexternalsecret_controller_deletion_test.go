@@ -0,0 +1,197 @@
+/*
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package clusterexternalsecret
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/go-logr/logr"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
+	"k8s.io/client-go/tools/record"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	esv1beta1 "github.com/external-secrets/external-secrets/apis/externalsecrets/v1beta1"
+)
+
+func newDeletionTestScheme(t *testing.T) *runtime.Scheme {
+	t.Helper()
+	scheme := runtime.NewScheme()
+	if err := clientgoscheme.AddToScheme(scheme); err != nil {
+		t.Fatalf("failed to add client-go types to scheme: %v", err)
+	}
+	if err := esv1beta1.AddToScheme(scheme); err != nil {
+		t.Fatalf("failed to add externalsecrets types to scheme: %v", err)
+	}
+	return scheme
+}
+
+// beginDeletion marks ces as deleted the way the apiserver would: since it carries a finalizer,
+// the fake client's Delete only stamps a DeletionTimestamp instead of removing the object.
+func beginDeletion(t *testing.T, c client.Client, ces *esv1beta1.ClusterExternalSecret) *esv1beta1.ClusterExternalSecret {
+	t.Helper()
+	if err := c.Delete(context.Background(), ces); err != nil {
+		t.Fatalf("failed to start deletion: %v", err)
+	}
+	var deleting esv1beta1.ClusterExternalSecret
+	if err := c.Get(context.Background(), client.ObjectKeyFromObject(ces), &deleting); err != nil {
+		t.Fatalf("failed to re-fetch ClusterExternalSecret: %v", err)
+	}
+	return &deleting
+}
+
+// TestReconcileDeletion_OrphanedExternalSecretIsDeleted verifies that reconcileDeletion removes an
+// ExternalSecret that was provisioned by this ClusterExternalSecret but has since drifted out of
+// our ownership (e.g. its owner reference was removed by hand), and only then drops the finalizer.
+func TestReconcileDeletion_OrphanedExternalSecretIsDeleted(t *testing.T) {
+	scheme := newDeletionTestScheme(t)
+
+	ces := &esv1beta1.ClusterExternalSecret{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:       "test-ces",
+			Finalizers: []string{CESFinalizer},
+		},
+		Status: esv1beta1.ClusterExternalSecretStatus{
+			ProvisionedNamespaces: []string{"team-a"},
+		},
+	}
+
+	orphan := &esv1beta1.ExternalSecret{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "test-ces",
+			Namespace: "team-a",
+			Labels:    map[string]string{CESNameLabelKey: "test-ces"},
+		},
+	}
+
+	c := fake.NewClientBuilder().WithScheme(scheme).WithObjects(ces, orphan).Build()
+	deleting := beginDeletion(t, c, ces)
+
+	r := &Reconciler{
+		Client:   c,
+		Scheme:   scheme,
+		Log:      logr.Discard(),
+		Recorder: record.NewFakeRecorder(10),
+		// Non-zero so that a requeue after a failed cleanup shows up in result.RequeueAfter.
+		RequeueInterval: time.Minute,
+	}
+
+	result, err := r.reconcileDeletion(context.Background(), r.Log, deleting)
+	if err != nil {
+		t.Fatalf("reconcileDeletion returned error: %v", err)
+	}
+	if result.RequeueAfter != 0 {
+		t.Fatalf("expected no requeue once the orphaned ExternalSecret is deleted, got %v", result.RequeueAfter)
+	}
+
+	var remaining esv1beta1.ExternalSecret
+	if err := c.Get(context.Background(), client.ObjectKeyFromObject(orphan), &remaining); err == nil {
+		t.Fatalf("expected orphaned ExternalSecret to be deleted, but it still exists")
+	}
+
+	// Removing the last finalizer from an object that already has a non-zero DeletionTimestamp
+	// causes the (fake) apiserver to actually delete it, mirroring real apiserver behavior.
+	var refreshed esv1beta1.ClusterExternalSecret
+	err = c.Get(context.Background(), client.ObjectKeyFromObject(ces), &refreshed)
+	if !apierrors.IsNotFound(err) {
+		t.Fatalf("expected ClusterExternalSecret to be gone once its last finalizer is removed, got err=%v", err)
+	}
+}
+
+// TestReconcileDeletion_MissingExternalSecretRemovesFinalizer verifies that a provisioned
+// namespace whose ExternalSecret is already gone doesn't block finalizer removal.
+func TestReconcileDeletion_MissingExternalSecretRemovesFinalizer(t *testing.T) {
+	scheme := newDeletionTestScheme(t)
+
+	ces := &esv1beta1.ClusterExternalSecret{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:       "test-ces",
+			Finalizers: []string{CESFinalizer},
+		},
+		Status: esv1beta1.ClusterExternalSecretStatus{
+			ProvisionedNamespaces: []string{"already-gone"},
+		},
+	}
+
+	c := fake.NewClientBuilder().WithScheme(scheme).WithObjects(ces).Build()
+	deleting := beginDeletion(t, c, ces)
+
+	r := &Reconciler{
+		Client:   c,
+		Scheme:   scheme,
+		Log:      logr.Discard(),
+		Recorder: record.NewFakeRecorder(10),
+		// Non-zero so that a requeue after a failed cleanup shows up in result.RequeueAfter.
+		RequeueInterval: time.Minute,
+	}
+
+	result, err := r.reconcileDeletion(context.Background(), r.Log, deleting)
+	if err != nil {
+		t.Fatalf("reconcileDeletion returned error: %v", err)
+	}
+	if result.RequeueAfter != 0 {
+		t.Fatalf("expected no requeue when there is nothing left to clean up, got %v", result.RequeueAfter)
+	}
+
+	// Removing the last finalizer from an object that already has a non-zero DeletionTimestamp
+	// causes the (fake) apiserver to actually delete it, mirroring real apiserver behavior.
+	var refreshed esv1beta1.ClusterExternalSecret
+	err = c.Get(context.Background(), client.ObjectKeyFromObject(ces), &refreshed)
+	if !apierrors.IsNotFound(err) {
+		t.Fatalf("expected ClusterExternalSecret to be gone once its last finalizer is removed, got err=%v", err)
+	}
+}
+
+// TestListOrphanedExternalSecretNamespaces_MatchesOnCESNameLabel verifies that the orphan sweep
+// finds ExternalSecrets carrying CESNameLabelKey even when the ClusterExternalSecret doesn't
+// configure any Spec.ExternalSecretMetadata.Labels of its own.
+func TestListOrphanedExternalSecretNamespaces_MatchesOnCESNameLabel(t *testing.T) {
+	scheme := newDeletionTestScheme(t)
+
+	ces := &esv1beta1.ClusterExternalSecret{
+		ObjectMeta: metav1.ObjectMeta{Name: "test-ces"},
+	}
+
+	matching := &esv1beta1.ExternalSecret{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "test-ces",
+			Namespace: "team-a",
+			Labels:    map[string]string{CESNameLabelKey: "test-ces"},
+		},
+	}
+	other := &esv1beta1.ExternalSecret{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "test-ces",
+			Namespace: "team-b",
+			Labels:    map[string]string{CESNameLabelKey: "other-ces"},
+		},
+	}
+
+	c := fake.NewClientBuilder().WithScheme(scheme).WithObjects(ces, matching, other).Build()
+	r := &Reconciler{Client: c, Scheme: scheme, Log: logr.Discard()}
+
+	namespaces, err := r.listOrphanedExternalSecretNamespaces(context.Background(), ces, "test-ces")
+	if err != nil {
+		t.Fatalf("listOrphanedExternalSecretNamespaces returned error: %v", err)
+	}
+	if len(namespaces) != 1 || namespaces[0] != "team-a" {
+		t.Fatalf("expected [team-a], got %v", namespaces)
+	}
+}
@@ -0,0 +1,137 @@
+/*
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package clusterexternalsecret
+
+import (
+	"context"
+	"testing"
+
+	"github.com/go-logr/logr"
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime"
+	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	esv1beta1 "github.com/external-secrets/external-secrets/apis/externalsecrets/v1beta1"
+)
+
+func newNamespace(name string) *v1.Namespace {
+	return &v1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: name}}
+}
+
+// TestGetMatchingNamespaces_ExcludeNamespacesOnly verifies that a ClusterExternalSecret which sets
+// only Spec.ExcludeNamespaces (no NamespaceSelector/NamespaceSelectors/Namespaces) still filters
+// the default "all namespaces" set, instead of ignoring the deny-list entirely.
+func TestGetMatchingNamespaces_ExcludeNamespacesOnly(t *testing.T) {
+	scheme := runtime.NewScheme()
+	if err := clientgoscheme.AddToScheme(scheme); err != nil {
+		t.Fatalf("failed to add client-go types to scheme: %v", err)
+	}
+
+	c := fake.NewClientBuilder().
+		WithScheme(scheme).
+		WithObjects(newNamespace("team-a"), newNamespace("team-b"), newNamespace("kube-system")).
+		Build()
+
+	r := &Reconciler{Client: c, Log: logr.Discard()}
+
+	spec := esv1beta1.ClusterExternalSecretSpec{
+		ExcludeNamespaces: []string{"kube-system"},
+	}
+
+	namespaceList, note, failures, err := r.getMatchingNamespaces(context.Background(), spec)
+	if err != nil {
+		t.Fatalf("getMatchingNamespaces returned error: %v", err)
+	}
+	if len(failures) != 0 {
+		t.Fatalf("expected no namespace creation failures, got %v", failures)
+	}
+	if note != messageEmptySelector {
+		t.Fatalf("expected note %q, got %q", messageEmptySelector, note)
+	}
+
+	got := map[string]bool{}
+	for _, ns := range namespaceList.Items {
+		got[ns.Name] = true
+	}
+	if got["kube-system"] {
+		t.Fatalf("expected kube-system to be excluded, got namespaces: %v", got)
+	}
+	if !got["team-a"] || !got["team-b"] {
+		t.Fatalf("expected team-a and team-b to be targeted, got namespaces: %v", got)
+	}
+}
+
+// TestSpecTargetsNamespace_ExcludeNamespacesOnly mirrors the fix above for the namespace-watch
+// fan-out: a lone ExcludeNamespaces entry must still exclude the namespace even though no positive
+// selector is configured.
+func TestSpecTargetsNamespace_ExcludeNamespacesOnly(t *testing.T) {
+	r := &Reconciler{}
+
+	spec := esv1beta1.ClusterExternalSecretSpec{
+		ExcludeNamespaces: []string{"kube-system"},
+	}
+
+	excluded, err := r.specTargetsNamespace(spec, "kube-system", labels.Set{})
+	if err != nil {
+		t.Fatalf("specTargetsNamespace returned error: %v", err)
+	}
+	if excluded {
+		t.Fatalf("expected kube-system to be excluded")
+	}
+
+	included, err := r.specTargetsNamespace(spec, "team-a", labels.Set{})
+	if err != nil {
+		t.Fatalf("specTargetsNamespace returned error: %v", err)
+	}
+	if !included {
+		t.Fatalf("expected team-a to be targeted by the default all-namespaces behaviour")
+	}
+}
+
+// TestGetMatchingNamespaces_ExcludeComposesWithNamespaces verifies that ExcludeNamespaces still
+// removes entries matched by Spec.Namespaces, i.e. the two compose rather than one short-circuiting
+// the other.
+func TestGetMatchingNamespaces_ExcludeComposesWithNamespaces(t *testing.T) {
+	scheme := runtime.NewScheme()
+	if err := clientgoscheme.AddToScheme(scheme); err != nil {
+		t.Fatalf("failed to add client-go types to scheme: %v", err)
+	}
+
+	c := fake.NewClientBuilder().
+		WithScheme(scheme).
+		WithObjects(newNamespace("team-a"), newNamespace("team-b")).
+		Build()
+
+	r := &Reconciler{Client: c, Log: logr.Discard()}
+
+	spec := esv1beta1.ClusterExternalSecretSpec{
+		Namespaces:        []string{"team-*"},
+		ExcludeNamespaces: []string{"team-b"},
+	}
+
+	namespaceList, note, _, err := r.getMatchingNamespaces(context.Background(), spec)
+	if err != nil {
+		t.Fatalf("getMatchingNamespaces returned error: %v", err)
+	}
+	if note != "" {
+		t.Fatalf("expected no note when namespaces matched, got %q", note)
+	}
+	if len(namespaceList.Items) != 1 || namespaceList.Items[0].Name != "team-a" {
+		t.Fatalf("expected only team-a, got %v", namespaceList.Items)
+	}
+}
@@ -0,0 +1,97 @@
+/*
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package clusterexternalsecret
+
+import (
+	"context"
+	"strings"
+
+	v1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	esv1beta1 "github.com/external-secrets/external-secrets/apis/externalsecrets/v1beta1"
+)
+
+// ApplyNamespace idempotently ensures a namespace exists with at least the labels and annotations
+// carried by template: it creates the namespace if it is missing, or patches in any labels/
+// annotations the existing namespace doesn't already have. Existing keys are never overwritten.
+func (r *Reconciler) ApplyNamespace(ctx context.Context, name string, template esv1beta1.NamespaceTemplate) (*v1.Namespace, error) {
+	var namespace v1.Namespace
+	err := r.Get(ctx, types.NamespacedName{Name: name}, &namespace)
+	if apierrors.IsNotFound(err) {
+		namespace = v1.Namespace{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:        name,
+				Labels:      template.Labels,
+				Annotations: template.Annotations,
+			},
+		}
+		if err := r.Create(ctx, &namespace); err != nil {
+			return nil, err
+		}
+		return &namespace, nil
+	} else if err != nil {
+		return nil, err
+	}
+
+	if !namespaceNeedsPatch(&namespace, template) {
+		return &namespace, nil
+	}
+
+	patch := client.MergeFrom(namespace.DeepCopy())
+	mergeMissing(&namespace.Labels, template.Labels)
+	mergeMissing(&namespace.Annotations, template.Annotations)
+	if err := r.Patch(ctx, &namespace, patch); err != nil {
+		return nil, err
+	}
+
+	return &namespace, nil
+}
+
+func namespaceNeedsPatch(namespace *v1.Namespace, template esv1beta1.NamespaceTemplate) bool {
+	return hasMissingKeys(namespace.Labels, template.Labels) || hasMissingKeys(namespace.Annotations, template.Annotations)
+}
+
+func hasMissingKeys(existing, wanted map[string]string) bool {
+	for k := range wanted {
+		if _, ok := existing[k]; !ok {
+			return true
+		}
+	}
+	return false
+}
+
+func mergeMissing(existing *map[string]string, wanted map[string]string) {
+	if len(wanted) == 0 {
+		return
+	}
+	if *existing == nil {
+		*existing = map[string]string{}
+	}
+	for k, v := range wanted {
+		if _, ok := (*existing)[k]; !ok {
+			(*existing)[k] = v
+		}
+	}
+}
+
+// isGlobPattern reports whether name contains characters that only make sense as a glob pattern,
+// in which case it cannot be auto-created since there is no single concrete namespace name to use.
+func isGlobPattern(name string) bool {
+	return strings.ContainsAny(name, "*?[")
+}